@@ -0,0 +1,135 @@
+package datatable
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+// CellRendererBool returns a [Column.CellRenderer] that shows a cell's
+// boolean value as a read-only checkbox. Pair it with [CellUpdaterBool] so
+// the checkbox is reused instead of recreated on every refresh.
+func CellRendererBool() func(row, col int, value any) fyne.CanvasObject {
+	return func(_, _ int, value any) fyne.CanvasObject {
+		b, _ := value.(bool)
+		c := widget.NewCheck("", func(bool) {})
+		c.SetChecked(b)
+		c.Disable()
+		return c
+	}
+}
+
+// CellUpdaterBool returns a [Column.CellUpdater] that updates a checkbox
+// created by [CellRendererBool] in place.
+func CellUpdaterBool() func(obj fyne.CanvasObject, row, col int, value any) {
+	return func(obj fyne.CanvasObject, _, _ int, value any) {
+		b, _ := value.(bool)
+		obj.(*widget.Check).SetChecked(b)
+	}
+}
+
+// CellRendererNumber returns a [Column.CellRenderer] that shows a cell's
+// numeric value right-aligned and formatted for p. If p is nil,
+// [language.English] is used. Pair it with [CellUpdaterNumber] (using the
+// same p) so the label is reused instead of recreated on every refresh, and
+// with [LessNumber] as the column's Less so it sorts numerically instead of
+// by the lexicographic order of the formatted string.
+func CellRendererNumber(p *message.Printer) func(row, col int, value any) fyne.CanvasObject {
+	if p == nil {
+		p = message.NewPrinter(language.English)
+	}
+	return func(_, _ int, value any) fyne.CanvasObject {
+		l := widget.NewLabel(formatNumber(p, value))
+		l.Alignment = fyne.TextAlignTrailing
+		return l
+	}
+}
+
+// CellUpdaterNumber returns a [Column.CellUpdater] that updates a label
+// created by [CellRendererNumber] in place. If p is nil, [language.English]
+// is used.
+func CellUpdaterNumber(p *message.Printer) func(obj fyne.CanvasObject, row, col int, value any) {
+	if p == nil {
+		p = message.NewPrinter(language.English)
+	}
+	return func(obj fyne.CanvasObject, _, _ int, value any) {
+		obj.(*widget.Label).SetText(formatNumber(p, value))
+	}
+}
+
+func formatNumber(p *message.Printer, value any) string {
+	switch v := value.(type) {
+	case int:
+		return p.Sprintf("%d", v)
+	case int32:
+		return p.Sprintf("%d", v)
+	case int64:
+		return p.Sprintf("%d", v)
+	case float32:
+		return p.Sprintf("%.2f", v)
+	case float64:
+		return p.Sprintf("%.2f", v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// LessNumber is a [Column.Less] for columns using [CellRendererNumber], so
+// the column sorts by numeric value instead of the lexicographic order of
+// its formatted string (e.g. so 9 sorts before 10).
+func LessNumber(a, b any) bool {
+	return numberValue(a) < numberValue(b)
+}
+
+func numberValue(value any) float64 {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	case float64:
+		return v
+	default:
+		return 0
+	}
+}
+
+// CellRendererTime returns a [Column.CellRenderer] that formats a cell's
+// time.Time value using layout (see the time package for layout syntax).
+// Pair it with [CellUpdaterTime] (using the same layout) so the label is
+// reused instead of recreated on every refresh, and with [LessTime] as the
+// column's Less so it sorts chronologically instead of by the lexicographic
+// order of the formatted string.
+func CellRendererTime(layout string) func(row, col int, value any) fyne.CanvasObject {
+	return func(_, _ int, value any) fyne.CanvasObject {
+		t, _ := value.(time.Time)
+		return widget.NewLabel(t.Format(layout))
+	}
+}
+
+// CellUpdaterTime returns a [Column.CellUpdater] that updates a label
+// created by [CellRendererTime] in place.
+func CellUpdaterTime(layout string) func(obj fyne.CanvasObject, row, col int, value any) {
+	return func(obj fyne.CanvasObject, _, _ int, value any) {
+		t, _ := value.(time.Time)
+		obj.(*widget.Label).SetText(t.Format(layout))
+	}
+}
+
+// LessTime is a [Column.Less] for columns using [CellRendererTime], so the
+// column sorts chronologically instead of by the lexicographic order of its
+// formatted string.
+func LessTime(a, b any) bool {
+	ta, _ := a.(time.Time)
+	tb, _ := b.(time.Time)
+	return ta.Before(tb)
+}
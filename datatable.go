@@ -5,8 +5,10 @@ import (
 	"cmp"
 	"errors"
 	"fmt"
+	"image/color"
 	"math"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -35,6 +37,26 @@ type Config struct {
 	// Whether to hide the search bar.
 	SearchBarHidden bool
 
+	// PerColumnFilters shows a row of per-column filter entries below the
+	// header, in addition to the global search bar. A row is shown only when
+	// it matches every active column filter as well as the global search.
+	PerColumnFilters bool
+
+	// ShowExportButton adds a button next to the search bar that opens a
+	// save-file dialog and writes the current view to CSV, TSV or JSON
+	// (chosen from the file name's extension) via [DataTable.Export].
+	// Requires Window.
+	ShowExportButton bool
+
+	// Window is the parent window for the save-file dialog opened by the
+	// export button. Only required when ShowExportButton is enabled.
+	Window fyne.Window
+
+	// SelectionMode configures whether and how many rows can be selected.
+	// Defaults to SelectionNone, which disables selection and keeps the
+	// legacy click-to-activate behavior of OnSelected.
+	SelectionMode SelectionMode
+
 	// Initially sorted column
 	SortedColumnIndex int
 
@@ -63,9 +85,66 @@ type Column struct {
 	//
 	// A column with width 0 will be auto-sized to fit the data in that column.
 	// The minimum width is the width needed to fit a column's title.
+	//
+	// Users can also resize any column but the last at runtime by dragging
+	// the divider to its right, or double-click that divider to autosize the
+	// column; see [DataTable.SetColumnWidth]. Dragging can make a column
+	// narrower than its title, down to a fixed minimum.
 	Width float32
+
+	// CellRenderer optionally renders a cell with a custom widget instead of
+	// the default label, e.g. an icon, a button or a progress bar. It is
+	// called once per scrolled-into-view cell slot, to create that slot's
+	// canvas object; row is the index of the row in the original
+	// (unsorted, unfiltered) data, or its position with a data provider, and
+	// value is the cell's value as set via [DataTable.SetDataAny], or the
+	// cell's string for data set with [DataTable.SetData] or a data provider.
+	//
+	// Pair it with CellUpdater so the created object is reused (mirroring
+	// how Fyne's widget.Table reuses cells via CreateCell/UpdateCell)
+	// instead of being recreated every time the row shown in that slot
+	// changes.
+	CellRenderer func(row, col int, value any) fyne.CanvasObject
+
+	// CellUpdater optionally updates a cell previously created by
+	// CellRenderer in place, instead of CellRenderer being called again.
+	// When nil, CellRenderer is called again for every cell refresh.
+	CellUpdater func(obj fyne.CanvasObject, row, col int, value any)
+
+	// Stringer converts a cell's value into the string used for the default
+	// label renderer and for filtering. When nil, fmt.Sprint is used.
+	//
+	// Only used for data set with [DataTable.SetDataAny].
+	Stringer func(value any) string
+
+	// Less reports whether a is less than b, so cells with typed values sort
+	// by their actual value instead of their string representation (e.g.
+	// numbers sort numerically instead of lexicographically). When nil,
+	// cells are compared by their string representation. Required for a
+	// column using CellRenderer to sort correctly; see [LessNumber] and
+	// [LessTime] for the built-in renderers.
+	//
+	// Only used for data set with [DataTable.SetDataAny].
+	Less func(a, b any) bool
+
+	// FilterFunc overrides how this column's per-column filter matches a
+	// cell against the user's query, e.g. for prefix, regex or numeric-range
+	// matching. When nil, a case-insensitive substring match is used.
+	//
+	// Only used when [Config.PerColumnFilters] is enabled.
+	FilterFunc func(cell, query string) bool
 }
 
+// A SelectionMode configures how many rows of a DataTable can be selected
+// at once.
+type SelectionMode uint
+
+const (
+	SelectionNone   SelectionMode = iota // Rows cannot be selected
+	SelectionSingle                      // At most one row can be selected at a time
+	SelectionMulti                       // Multiple rows can be selected at once
+)
+
 // A SortDir represents the sort directions for a column
 type SortDir uint
 
@@ -75,16 +154,39 @@ const (
 	SortDesc                // Sort descending
 )
 
+// A SortSpec describes one column's contribution to a multi-column sort, for
+// use with [DataTable.SetSortFilterHandler].
+type SortSpec struct {
+	// Col is the index of the sorted column.
+	Col int
+
+	// Dir is the direction the column is sorted in.
+	Dir SortDir
+}
+
 // characters for showing sort direction
 const (
 	characterSortAsc  = "↑"
 	characterSortDesc = "↓"
 )
 
+// superscriptDigits renders the priority index shown next to a column's sort
+// arrow when more than one column is sorted at once (e.g. "Name↑¹").
+var superscriptDigits = [...]string{"⁰", "¹", "²", "³", "⁴", "⁵", "⁶", "⁷", "⁸", "⁹"}
+
+func superscript(n int) string {
+	var b strings.Builder
+	for _, r := range strconv.Itoa(n) {
+		b.WriteString(superscriptDigits[r-'0'])
+	}
+	return b.String()
+}
+
 // A row in a DataTable
 type row struct {
 	idx     int // index of this row in the original data
 	columns []string
+	values  []any // the row's typed values; nil unless set via SetDataAny
 }
 
 // A DataTable is a Fyne widget implementing a data-driven table.
@@ -95,24 +197,58 @@ type DataTable struct {
 	// index refers to the row in the original data.
 	OnSelected func(index int)
 
+	// OnSelectionChanged runs whenever the set of selected rows changes.
+	// rows holds the indices of the selected rows in the original data,
+	// sorted in ascending order.
+	OnSelectionChanged func(rows []int)
+
 	widget.BaseWidget
-	alignments      []Alignment
-	body            *widget.List
-	footer          *widget.Label
-	footerHidden    bool
-	header          []fyne.CanvasObject
-	headerCells     []string
-	headerHidden    bool
-	numCols         int
-	searchBar       *widget.Entry
-	searchBarHidden bool
-	widths          []float32
-
-	mu            sync.RWMutex
-	layout        columnsLayout
-	cells         []row
-	cellsFiltered []row
-	sortCols      []SortDir
+	alignments       []Alignment
+	columns          []Column
+	body             *widget.List
+	footer           *widget.Label
+	footerHidden     bool
+	header           []fyne.CanvasObject
+	headerCells      []string
+	headerHidden     bool
+	numCols          int
+	perColumnFilters bool
+	filterBar        []fyne.CanvasObject
+	selectionMode    SelectionMode
+	searchBar        *widget.Entry
+	searchBarHidden  bool
+	widths           []float32
+	dividers         []fyne.CanvasObject
+	headerRow        *fyne.Container
+	filterRow        *fyne.Container
+	dividerOverlay   *fyne.Container
+	showExportButton bool
+	window           fyne.Window
+
+	// shiftHeld and ctrlHeld track the live state of the Shift and
+	// Ctrl/Cmd modifier keys. Keyboard navigation maintains them via
+	// KeyDown/KeyUp; row clicks instead set them directly from the mouse
+	// event's modifiers (see rowMouseArea), since KeyDown/KeyUp are only
+	// delivered to the table once it already has keyboard focus. They are
+	// only ever touched on the Fyne UI goroutine, like the rest of this
+	// widget's event handlers.
+	shiftHeld bool
+	ctrlHeld  bool
+
+	mu                sync.RWMutex
+	layout            columnsLayout
+	cells             []row
+	cellsFiltered     []row
+	sortCols          []SortDir
+	sortPriority      []int    // ordered indices of the columns currently taking part in the sort
+	filterQueries     []string // current per-column filter query for each column
+	selected          map[int]bool
+	focusedRow        int // index into cellsFiltered (or the provider) of the keyboard-focused row, -1 if none
+	anchorRow         int // index into cellsFiltered (or the provider) anchoring a Shift range selection, -1 if none
+	dataProvider      func(offset, limit int) [][]string
+	providerTotal     int
+	pageCache         *pageCache
+	sortFilterHandler func(sort []SortSpec, query string, filters map[int]string) int
 }
 
 // New returns a new DataTable widget.
@@ -122,20 +258,32 @@ func New(config Config) (*DataTable, error) {
 	if len(config.Columns) == 0 {
 		return nil, fmt.Errorf("no headers defined")
 	}
+	if config.ShowExportButton && config.Window == nil {
+		return nil, errors.New("window is required when ShowExportButton is enabled")
+	}
 	numCols := len(config.Columns)
 	headerCells := make([]string, numCols)
 	for i, c := range config.Columns {
 		headerCells[i] = c.Title
 	}
 	w := &DataTable{
-		alignments:      make([]Alignment, numCols),
-		footer:          widget.NewLabel(""),
-		footerHidden:    config.FooterHidden,
-		headerCells:     headerCells,
-		headerHidden:    config.HeaderHidden,
-		numCols:         numCols,
-		searchBarHidden: config.SearchBarHidden,
-		sortCols:        make([]SortDir, numCols),
+		alignments:       make([]Alignment, numCols),
+		columns:          slices.Clone(config.Columns),
+		footer:           widget.NewLabel(""),
+		footerHidden:     config.FooterHidden,
+		headerCells:      headerCells,
+		headerHidden:     config.HeaderHidden,
+		numCols:          numCols,
+		perColumnFilters: config.PerColumnFilters,
+		filterQueries:    make([]string, numCols),
+		selectionMode:    config.SelectionMode,
+		selected:         make(map[int]bool),
+		focusedRow:       -1,
+		anchorRow:        -1,
+		searchBarHidden:  config.SearchBarHidden,
+		sortCols:         make([]SortDir, numCols),
+		showExportButton: config.ShowExportButton,
+		window:           config.Window,
 	}
 
 	// column widths
@@ -164,11 +312,16 @@ func New(config Config) (*DataTable, error) {
 		return nil, errors.New("invalid index for initial sort column")
 	}
 	w.sortCols[config.SortedColumnIndex] = config.SortedColumnDirection
+	w.sortPriority = []int{config.SortedColumnIndex}
 
 	w.ExtendBaseWidget(w)
 	w.body = w.makeBody()
 	w.header = w.makeHeader()
+	w.dividers = w.makeDividers()
 	w.searchBar = w.makeSearchBar()
+	if w.perColumnFilters {
+		w.filterBar = w.makeFilterBar()
+	}
 	return w, nil
 }
 
@@ -191,14 +344,54 @@ func (w *DataTable) makeSearchBar() *widget.Entry {
 	return e
 }
 
+// makeFilterBar returns the per-column filter entries shown below the header
+// when [Config.PerColumnFilters] is enabled.
+func (w *DataTable) makeFilterBar() []fyne.CanvasObject {
+	objects := make([]fyne.CanvasObject, w.numCols)
+	for col := range objects {
+		col := col
+		e := widget.NewEntry()
+		e.PlaceHolder = w.headerCells[col]
+		e.OnChanged = func(s string) {
+			w.mu.Lock()
+			w.filterQueries[col] = s
+			w.mu.Unlock()
+			w.applyFilterAndSort(w.searchBar.Text)
+		}
+		objects[col] = e
+	}
+	return objects
+}
+
 func (w *DataTable) applyFilterAndSort(search string) {
+	selectionCleared := false
 	func() {
 		w.mu.Lock()
 		defer w.mu.Unlock()
 		w.applySort()
+		if w.dataProvider != nil {
+			if w.sortFilterHandler != nil {
+				w.providerTotal = w.sortFilterHandler(w.sortSpecsLocked(), search, w.columnFiltersLocked())
+			}
+			w.pageCache.clear()
+			// The provider's row order can no longer be trusted (same reason
+			// pageCache is cleared above), and w.selected/focusedRow/anchorRow
+			// are keyed by position within it, so a stale selection would now
+			// point at different rows than the ones the user actually picked.
+			if len(w.selected) > 0 {
+				selectionCleared = true
+			}
+			w.selected = make(map[int]bool)
+			w.focusedRow = -1
+			w.anchorRow = -1
+			return
+		}
 		var selection []row
 		s2 := strings.ToLower(search)
 		for _, row := range w.cells {
+			if !w.matchesColumnFilters(row) {
+				continue
+			}
 			match := false
 			for _, c := range row.columns {
 				c2 := strings.ToLower(c)
@@ -213,10 +406,52 @@ func (w *DataTable) applyFilterAndSort(search string) {
 		}
 		w.cellsFiltered = selection
 	}()
+	if selectionCleared {
+		w.notifySelectionChanged()
+	}
 	w.updateFooter()
 	w.body.Refresh()
 }
 
+// columnFiltersLocked returns the active per-column filter queries, keyed by
+// column index; columns with an empty query are omitted. Callers must hold
+// w.mu.
+func (w *DataTable) columnFiltersLocked() map[int]string {
+	if !w.perColumnFilters {
+		return nil
+	}
+	filters := make(map[int]string)
+	for i, q := range w.filterQueries {
+		if q != "" {
+			filters[i] = q
+		}
+	}
+	return filters
+}
+
+// matchesColumnFilters reports whether r satisfies every active per-column
+// filter query. A column with an empty query always matches.
+func (w *DataTable) matchesColumnFilters(r row) bool {
+	if !w.perColumnFilters {
+		return true
+	}
+	for i, q := range w.filterQueries {
+		if q == "" {
+			continue
+		}
+		if f := w.columns[i].FilterFunc; f != nil {
+			if !f(r.columns[i], q) {
+				return false
+			}
+			continue
+		}
+		if !strings.Contains(strings.ToLower(r.columns[i]), strings.ToLower(q)) {
+			return false
+		}
+	}
+	return true
+}
+
 func (w *DataTable) applySort() {
 	for i, x := range w.header {
 		t := w.headerCells[i]
@@ -229,21 +464,84 @@ func (w *DataTable) applySort() {
 		case SortDesc:
 			t2 = t + characterSortDesc
 		}
+		if p := slices.Index(w.sortPriority, i); p != -1 && len(w.sortPriority) > 1 {
+			t2 += superscript(p + 1)
+		}
 		l := x.(*tappableLabel)
 		l.SetText(t2)
 	}
-	for i, c := range w.sortCols {
-		switch c {
-		case SortAsc:
-			slices.SortFunc(w.cells, func(a, b row) int {
-				return cmp.Compare(a.columns[i], b.columns[i])
-			})
-		case SortDesc:
-			slices.SortFunc(w.cells, func(a, b row) int {
-				return cmp.Compare(b.columns[i], a.columns[i])
-			})
+	if w.dataProvider != nil {
+		// Sorting the backing data is delegated to SetSortFilterHandler (or
+		// skipped entirely) in applyFilterAndSort; there is nothing in
+		// memory to sort here.
+		return
+	}
+	slices.SortStableFunc(w.cells, func(a, b row) int {
+		for _, i := range w.sortPriority {
+			var c int
+			switch w.sortCols[i] {
+			case SortAsc:
+				c = w.compareCells(i, a, b)
+			case SortDesc:
+				c = w.compareCells(i, b, a)
+			}
+			if c != 0 {
+				return c
+			}
 		}
+		return 0
+	})
+}
+
+// sortSpecsLocked returns the active sort columns in priority order, for
+// handing off to a [DataTable.SetSortFilterHandler]. Callers must hold w.mu.
+func (w *DataTable) sortSpecsLocked() []SortSpec {
+	specs := make([]SortSpec, len(w.sortPriority))
+	for i, col := range w.sortPriority {
+		specs[i] = SortSpec{Col: col, Dir: w.sortCols[col]}
 	}
+	return specs
+}
+
+// compareCells compares the cells of a and b in column col, using that
+// column's Less function when the rows carry typed values, and falling back
+// to a plain string comparison otherwise.
+func (w *DataTable) compareCells(col int, a, b row) int {
+	if less := w.columns[col].Less; less != nil && a.values != nil && b.values != nil {
+		va, vb := a.values[col], b.values[col]
+		switch {
+		case less(va, vb):
+			return -1
+		case less(vb, va):
+			return 1
+		default:
+			return 0
+		}
+	}
+	return cmp.Compare(a.columns[col], b.columns[col])
+}
+
+// SetSort sets the columns the table is sorted by and their sort directions
+// programmatically. cols lists the sort priority, highest first, and dirs
+// holds the matching direction for each entry; both must have the same length.
+func (w *DataTable) SetSort(cols []int, dirs []SortDir) error {
+	if len(cols) != len(dirs) {
+		return fmt.Errorf("cols and dirs must have the same length")
+	}
+	w.mu.Lock()
+	sortCols := make([]SortDir, w.numCols)
+	for i, col := range cols {
+		if col < 0 || col >= w.numCols {
+			w.mu.Unlock()
+			return fmt.Errorf("invalid index for sort column: %d", col)
+		}
+		sortCols[col] = dirs[i]
+	}
+	w.sortCols = sortCols
+	w.sortPriority = slices.Clone(cols)
+	w.mu.Unlock()
+	w.applyFilterAndSort(w.searchBar.Text)
+	return nil
 }
 
 func (w *DataTable) makeHeader() []fyne.CanvasObject {
@@ -260,18 +558,33 @@ func (w *DataTable) makeHeader() []fyne.CanvasObject {
 		case AlignTrailing:
 			o.Alignment = fyne.TextAlignTrailing
 		}
-		o.OnTapped = func() {
-			for i := 0; i < w.numCols; i++ {
-				if i == col {
-					if w.sortCols[col] == SortDesc {
-						w.sortCols[col] = SortAsc
-					} else {
-						w.sortCols[col]++
+		o.OnTapped = func(shift bool) {
+			w.mu.Lock()
+			if shift {
+				// Shift-click adds (or cycles) this column in the sort priority
+				// list instead of replacing the current sort.
+				if idx := slices.Index(w.sortPriority, col); idx == -1 {
+					w.sortCols[col] = SortAsc
+					w.sortPriority = append(w.sortPriority, col)
+				} else if w.sortCols[col] == SortDesc {
+					w.sortCols[col] = SortAsc
+				} else {
+					w.sortCols[col]++
+				}
+			} else {
+				for i := 0; i < w.numCols; i++ {
+					if i != col {
+						w.sortCols[i] = sortOff
 					}
+				}
+				if w.sortCols[col] == SortDesc {
+					w.sortCols[col] = SortAsc
 				} else {
-					w.sortCols[i] = sortOff
+					w.sortCols[col]++
 				}
+				w.sortPriority = []int{col}
 			}
+			w.mu.Unlock()
 			w.applyFilterAndSort(w.searchBar.Text)
 		}
 		objects[col] = o
@@ -284,28 +597,63 @@ func (w *DataTable) makeBody() *widget.List {
 		func() int {
 			w.mu.RLock()
 			defer w.mu.RUnlock()
-			return len(w.cellsFiltered)
+			return w.visibleLenLocked()
 		},
 		func() fyne.CanvasObject {
 			w.mu.RLock()
 			defer w.mu.RUnlock()
 			objects := make([]fyne.CanvasObject, w.numCols)
 			for i := 0; i < w.numCols; i++ {
+				if w.columns[i].CellRenderer != nil {
+					objects[i] = container.NewStack()
+					continue
+				}
 				l := widget.NewLabel("")
 				l.Truncation = fyne.TextTruncateEllipsis
 				objects[i] = l
 			}
-			return container.New(w.layout, objects...)
+			bg := canvas.NewRectangle(color.Transparent)
+			content := container.NewStack(bg, container.New(w.layout, objects...))
+			return newRowMouseArea(content, func(modifier fyne.KeyModifier) {
+				w.mu.Lock()
+				w.shiftHeld = modifier&fyne.KeyModifierShift != 0
+				w.ctrlHeld = modifier&(fyne.KeyModifierControl|fyne.KeyModifierSuper) != 0
+				w.mu.Unlock()
+			})
 		},
 		func(id widget.ListItemID, co fyne.CanvasObject) {
-			w.mu.RLock()
-			defer w.mu.RUnlock()
-			if id >= len(w.cellsFiltered) {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+			r, ok := w.rowAt(id)
+			if !ok {
 				return // safeguard
 			}
-			r := w.cellsFiltered[id]
-			c := co.(*fyne.Container)
+			stack := co.(*rowMouseArea).content.(*fyne.Container)
+			bg := stack.Objects[0].(*canvas.Rectangle)
+			if w.selected[r.idx] {
+				bg.FillColor = theme.Color(theme.ColorNameSelection)
+			} else {
+				bg.FillColor = color.Transparent
+			}
+			bg.Refresh()
+			c := stack.Objects[1].(*fyne.Container)
 			for i := 0; i < w.numCols; i++ {
+				if renderer := w.columns[i].CellRenderer; renderer != nil {
+					var value any
+					if r.values != nil {
+						value = r.values[i]
+					} else {
+						value = r.columns[i]
+					}
+					slot := c.Objects[i].(*fyne.Container)
+					if updater := w.columns[i].CellUpdater; updater != nil && len(slot.Objects) == 1 {
+						updater(slot.Objects[0], r.idx, i, value)
+					} else {
+						slot.Objects = []fyne.CanvasObject{renderer(r.idx, i, value)}
+					}
+					slot.Refresh()
+					continue
+				}
 				o := c.Objects[i].(*widget.Label)
 				switch w.alignments[i] {
 				case AlignLeading:
@@ -321,19 +669,119 @@ func (w *DataTable) makeBody() *widget.List {
 	)
 	list.OnSelected = func(id widget.ListItemID) {
 		defer list.UnselectAll()
-		if w.OnSelected == nil {
+		w.mu.Lock()
+		r, ok := w.rowAt(id)
+		if !ok {
+			w.mu.Unlock()
 			return
 		}
-		w.mu.RLock()
-		defer w.mu.RUnlock()
-		if id >= len(w.cellsFiltered) {
-			return // safeguard
+		switch w.selectionMode {
+		case SelectionSingle:
+			w.selected = map[int]bool{r.idx: true}
+			w.focusedRow = id
+			w.anchorRow = id
+		case SelectionMulti:
+			switch {
+			case w.ctrlHeld:
+				if w.selected[r.idx] {
+					delete(w.selected, r.idx)
+				} else {
+					w.selected[r.idx] = true
+				}
+				w.anchorRow = id
+			case w.shiftHeld && w.anchorRow != -1:
+				w.selectRangeLocked(w.anchorRow, id)
+			default:
+				w.selected = map[int]bool{r.idx: true}
+				w.anchorRow = id
+			}
+			w.focusedRow = id
+		}
+		w.mu.Unlock()
+		if w.selectionMode != SelectionNone {
+			fyne.CurrentApp().Driver().CanvasForObject(w).Focus(w)
+			w.notifySelectionChanged()
+			w.body.Refresh()
+		}
+		if w.OnSelected != nil {
+			w.OnSelected(r.idx)
 		}
-		w.OnSelected(w.cellsFiltered[id].idx)
 	}
 	return list
 }
 
+// selectRangeLocked replaces the selection with every row between the
+// positions from and to (inclusive, indices among the currently visible
+// rows). Callers must hold w.mu.
+func (w *DataTable) selectRangeLocked(from, to int) {
+	if from > to {
+		from, to = to, from
+	}
+	w.selected = make(map[int]bool)
+	for i := from; i <= to && i < w.visibleLenLocked(); i++ {
+		if r, ok := w.rowAt(i); ok {
+			w.selected[r.idx] = true
+		}
+	}
+}
+
+// SelectedRows returns the indices (in the original data) of the currently
+// selected rows, sorted in ascending order.
+func (w *DataTable) SelectedRows() []int {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.selectedRowsLocked()
+}
+
+// selectedRowsLocked returns the sorted indices of the selected rows.
+// Callers must hold w.mu (for reading or writing).
+func (w *DataTable) selectedRowsLocked() []int {
+	rows := make([]int, 0, len(w.selected))
+	for idx := range w.selected {
+		rows = append(rows, idx)
+	}
+	slices.Sort(rows)
+	return rows
+}
+
+// SelectRow selects the row at idx, its index in the original data. In
+// SelectionSingle mode this replaces the current selection; in
+// SelectionMulti mode it is added to it. Does nothing when the table's
+// SelectionMode is SelectionNone.
+func (w *DataTable) SelectRow(idx int) {
+	w.mu.Lock()
+	switch w.selectionMode {
+	case SelectionNone:
+		w.mu.Unlock()
+		return
+	case SelectionSingle:
+		w.selected = map[int]bool{idx: true}
+	case SelectionMulti:
+		w.selected[idx] = true
+	}
+	w.mu.Unlock()
+	w.notifySelectionChanged()
+	w.body.Refresh()
+}
+
+// ClearSelection deselects all rows.
+func (w *DataTable) ClearSelection() {
+	w.mu.Lock()
+	w.selected = make(map[int]bool)
+	w.focusedRow = -1
+	w.anchorRow = -1
+	w.mu.Unlock()
+	w.notifySelectionChanged()
+	w.body.Refresh()
+}
+
+func (w *DataTable) notifySelectionChanged() {
+	if w.OnSelectionChanged == nil {
+		return
+	}
+	w.OnSelectionChanged(w.SelectedRows())
+}
+
 // SetData sets the content of all cells in the table.
 // Returns an error if not all rows have the expected number of columns.
 func (w *DataTable) SetData(cells [][]string) error {
@@ -357,6 +805,153 @@ func (w *DataTable) SetData(cells [][]string) error {
 	return nil
 }
 
+// SetDataAny sets the content of all cells in the table from typed values.
+// It is the counterpart to [DataTable.SetData] for columns using a
+// [Column.CellRenderer]; use a column's Stringer and Less to control how its
+// typed values are displayed and sorted as plain text.
+// Returns an error if not all rows have the expected number of columns.
+func (w *DataTable) SetDataAny(cells [][]any) error {
+	defer w.body.Refresh()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, r := range cells {
+		if len(r) != w.numCols {
+			return fmt.Errorf("some rows do not have %d columns", w.numCols)
+		}
+	}
+	w.cells = make([]row, len(cells))
+	strs := make([][]string, len(cells))
+	for i, r := range cells {
+		cols := make([]string, w.numCols)
+		for j, v := range r {
+			cols[j] = w.stringify(j, v)
+		}
+		w.cells[i] = row{idx: i, columns: cols, values: slices.Clone(r)}
+		strs[i] = cols
+	}
+	w.cellsFiltered = slices.Clone(w.cells)
+	allCells := slices.Concat([][]string{headersForWidthsCalc(w.headerCells)}, strs)
+	w.layout = columnsLayout(minimalColumnWidths(allCells, w.widths))
+	w.applySort()
+	w.updateFooter()
+	return nil
+}
+
+// stringify converts a cell's value in column col to its string
+// representation, using that column's Stringer when defined.
+func (w *DataTable) stringify(col int, value any) string {
+	if s := w.columns[col].Stringer; s != nil {
+		return s(value)
+	}
+	return fmt.Sprint(value)
+}
+
+// SetDataProvider backs the table with fetch instead of materializing all
+// rows in memory, so it can hold datasets with millions of rows. fetch
+// returns up to limit rows starting at offset, in the provider's current
+// sort/filter order; total is the number of rows currently available from
+// it. pageSize controls how many rows are fetched and cached together per
+// call to fetch, e.g. to tune it against the dataset's typical page size or
+// fetch's latency; if 0, dataProviderPageSize is used. Calling
+// SetDataProvider takes precedence over [DataTable.SetData] and
+// [DataTable.SetDataAny].
+//
+// Without a [DataTable.SetSortFilterHandler], the table has no way to
+// resort or refilter the provider's rows, so the search bar, per-column
+// filters and header sorting are disabled instead of silently having no
+// effect.
+func (w *DataTable) SetDataProvider(total, pageSize int, fetch func(offset, limit int) [][]string) {
+	if pageSize <= 0 {
+		pageSize = dataProviderPageSize
+	}
+	w.mu.Lock()
+	w.dataProvider = fetch
+	w.providerTotal = total
+	w.pageCache = newPageCache(pageSize)
+	w.mu.Unlock()
+	w.updateProviderControls()
+	w.updateFooter()
+	w.body.Refresh()
+}
+
+// SetSortFilterHandler delegates sorting and filtering to handler while a
+// [DataTable.SetDataProvider] is in use. handler receives the active sort
+// columns (highest priority first), the current search query, and the
+// active per-column filter queries (keyed by column index, omitting columns
+// with an empty query) when [Config.PerColumnFilters] is enabled; it
+// re-points the backing data at the matching, sorted rows, and returns the
+// resulting total row count. It is called whenever the user changes the
+// sort or a search/filter query, and whenever [DataTable.SetSort] is called.
+// Registering a handler re-enables the search bar, per-column filters and
+// header sorting if a prior [DataTable.SetDataProvider] call had disabled
+// them.
+func (w *DataTable) SetSortFilterHandler(handler func(sort []SortSpec, query string, filters map[int]string) int) {
+	w.mu.Lock()
+	w.sortFilterHandler = handler
+	w.mu.Unlock()
+	w.updateProviderControls()
+}
+
+// updateProviderControls enables or disables the search bar, per-column
+// filter bar and header sort taps depending on whether they could currently
+// have any effect: with a data provider but no [DataTable.SetSortFilterHandler],
+// nothing can resort or refilter the provider's rows.
+func (w *DataTable) updateProviderControls() {
+	w.mu.RLock()
+	disabled := w.dataProvider != nil && w.sortFilterHandler == nil
+	w.mu.RUnlock()
+	if w.searchBar != nil {
+		if disabled {
+			w.searchBar.Disable()
+		} else {
+			w.searchBar.Enable()
+		}
+	}
+	for _, o := range w.filterBar {
+		e := o.(*widget.Entry)
+		if disabled {
+			e.Disable()
+		} else {
+			e.Enable()
+		}
+	}
+	for _, o := range w.header {
+		l := o.(*tappableLabel)
+		l.SetDisabled(disabled)
+	}
+}
+
+// visibleLenLocked returns the number of rows currently shown in the body:
+// the data provider's total when one is set, or len(cellsFiltered)
+// otherwise. Callers must hold w.mu (for reading or writing).
+func (w *DataTable) visibleLenLocked() int {
+	if w.dataProvider != nil {
+		return w.providerTotal
+	}
+	return len(w.cellsFiltered)
+}
+
+// rowAt returns the row to display at position id in the body list: a
+// position into cellsFiltered for in-memory data, or an offset into the
+// data provider when one is set. Callers must hold w.mu for writing, since a
+// data-provider cache miss mutates the page cache.
+func (w *DataTable) rowAt(id int) (row, bool) {
+	if w.dataProvider != nil {
+		if id < 0 || id >= w.providerTotal {
+			return row{}, false
+		}
+		cols := w.pageCache.row(id, w.dataProvider)
+		if cols == nil {
+			return row{}, false
+		}
+		return row{idx: id, columns: cols}, true
+	}
+	if id < 0 || id >= len(w.cellsFiltered) {
+		return row{}, false
+	}
+	return w.cellsFiltered[id], true
+}
+
 func headersForWidthsCalc(header []string) []string {
 	h2 := make([]string, len(header))
 	for i, v := range header {
@@ -368,7 +963,9 @@ func headersForWidthsCalc(header []string) []string {
 func (w *DataTable) updateFooter() {
 	var s string
 	p := message.NewPrinter(language.English)
-	if len(w.cellsFiltered) < len(w.cells) {
+	if w.dataProvider != nil {
+		s = p.Sprintf("%d entries", w.providerTotal)
+	} else if len(w.cellsFiltered) < len(w.cells) {
 		s = p.Sprintf("%d of %d entries (filtered)", len(w.cellsFiltered), len(w.cells))
 	} else {
 		s = p.Sprintf("%d entries", len(w.cells))
@@ -400,24 +997,132 @@ func minimalColumnWidths(cells [][]string, widths []float32) []float32 {
 	return colWidths
 }
 
+// minColumnWidth is the smallest width a column can be dragged down to.
+const minColumnWidth float32 = 20
+
+// makeDividers returns one draggable handle per boundary between adjacent
+// columns, used to resize the column to its left.
+func (w *DataTable) makeDividers() []fyne.CanvasObject {
+	if w.numCols == 0 {
+		return nil
+	}
+	dividers := make([]fyne.CanvasObject, w.numCols-1)
+	for col := range dividers {
+		dividers[col] = newColumnDivider(w, col)
+	}
+	return dividers
+}
+
+// ColumnWidth returns column col's current on-screen width.
+// Returns an error if col is out of range.
+func (w *DataTable) ColumnWidth(col int) (float32, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if col < 0 || col >= w.numCols {
+		return 0, fmt.Errorf("invalid index for column: %d", col)
+	}
+	return w.layout[col], nil
+}
+
+// SetColumnWidth sets column col's on-screen width to width (raised to
+// minColumnWidth if it is smaller), overriding its
+// auto-sized or configured width until the user drags or double-clicks its
+// divider, or SetColumnWidth is called again.
+// Returns an error if col is out of range.
+func (w *DataTable) SetColumnWidth(col int, width float32) error {
+	w.mu.Lock()
+	if col < 0 || col >= w.numCols {
+		w.mu.Unlock()
+		return fmt.Errorf("invalid index for column: %d", col)
+	}
+	width = max(width, minColumnWidth)
+	w.widths[col] = width
+	w.layout[col] = width
+	w.mu.Unlock()
+	w.refreshLayout()
+	return nil
+}
+
+// resizeColumn grows or shrinks column col's width by dx, the pixel delta of
+// an in-progress divider drag, and persists the result in w.widths so a
+// later SetData/SetDataAny call keeps it, widened if need be to fit the
+// column's title.
+func (w *DataTable) resizeColumn(col int, dx float32) {
+	w.mu.Lock()
+	width := max(w.layout[col]+dx, minColumnWidth)
+	w.widths[col] = width
+	w.layout[col] = width
+	w.mu.Unlock()
+	w.refreshLayout()
+}
+
+// autosizeColumn recomputes column col's width to fit its header and current
+// data, as if it had never been manually resized.
+func (w *DataTable) autosizeColumn(col int) {
+	w.mu.Lock()
+	widths := slices.Clone(w.widths)
+	widths[col] = 0
+	allCells := make([][]string, 0, len(w.cells)+1)
+	allCells = append(allCells, headersForWidthsCalc(w.headerCells))
+	for _, r := range w.cells {
+		allCells = append(allCells, r.columns)
+	}
+	computed := minimalColumnWidths(allCells, widths)
+	w.widths[col] = computed[col]
+	w.layout[col] = computed[col]
+	w.mu.Unlock()
+	w.refreshLayout()
+}
+
+// refreshLayout re-triggers the header, filter bar, divider overlay and body
+// layouts after a column width changed, since they all share w.layout.
+func (w *DataTable) refreshLayout() {
+	if w.headerRow != nil {
+		w.headerRow.Refresh()
+	}
+	if w.filterRow != nil {
+		w.filterRow.Refresh()
+	}
+	if w.dividerOverlay != nil {
+		w.dividerOverlay.Refresh()
+	}
+	w.body.Refresh()
+}
+
 func (w *DataTable) CreateRenderer() fyne.WidgetRenderer {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 	var header, footer, searchBar fyne.CanvasObject
+	var headerItems []fyne.CanvasObject
 	if !w.headerHidden {
-		header = container.NewVBox(
-			container.NewStack(
-				canvas.NewRectangle(theme.Color(theme.ColorNameHeaderBackground)),
-				container.New(w.layout, w.header...),
-			),
-			widget.NewSeparator(),
-		)
+		w.headerRow = container.New(w.layout, w.header...)
+		w.dividerOverlay = container.New(dividerLayout{w.layout}, w.dividers...)
+		headerItems = append(headerItems, container.NewStack(
+			canvas.NewRectangle(theme.Color(theme.ColorNameHeaderBackground)),
+			w.headerRow,
+			w.dividerOverlay,
+		))
+	}
+	if w.perColumnFilters {
+		// Shown independently of HeaderHidden: the per-column filter row is
+		// a filtering control, not part of the header itself.
+		w.filterRow = container.New(w.layout, w.filterBar...)
+		headerItems = append(headerItems, w.filterRow)
+	}
+	if len(headerItems) > 0 {
+		headerItems = append(headerItems, widget.NewSeparator())
+		header = container.NewVBox(headerItems...)
 	}
 	if !w.footerHidden {
 		footer = container.NewVBox(widget.NewSeparator(), w.footer)
 	}
 	if !w.searchBarHidden {
-		searchBar = w.searchBar
+		if w.showExportButton {
+			exportButton := widget.NewButtonWithIcon("", theme.DocumentSaveIcon(), w.showExportDialog)
+			searchBar = container.NewBorder(nil, nil, nil, exportButton, w.searchBar)
+		} else {
+			searchBar = w.searchBar
+		}
 	}
 	c := container.NewBorder(
 		searchBar,
@@ -0,0 +1,101 @@
+package datatable
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+)
+
+// An ExportFormat selects the serialization used by [DataTable.Export].
+type ExportFormat uint
+
+const (
+	ExportCSV  ExportFormat = iota // Comma-separated values
+	ExportTSV                      // Tab-separated values
+	ExportJSON                     // Array of objects keyed by column title
+)
+
+// Export writes the currently filtered and sorted rows, including the
+// header row, to out in the given format.
+//
+// Rows backed by a [DataTable.SetDataProvider] are not included, since they
+// are not all held in memory.
+func (w *DataTable) Export(out io.Writer, format ExportFormat) error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	switch format {
+	case ExportCSV:
+		return w.exportDelimitedLocked(out, ',')
+	case ExportTSV:
+		return w.exportDelimitedLocked(out, '\t')
+	case ExportJSON:
+		return w.exportJSONLocked(out)
+	default:
+		return fmt.Errorf("unknown export format: %d", format)
+	}
+}
+
+// exportDelimitedLocked writes the header row followed by cellsFiltered as
+// delimiter-separated values. Callers must hold w.mu.
+func (w *DataTable) exportDelimitedLocked(out io.Writer, comma rune) error {
+	cw := csv.NewWriter(out)
+	cw.Comma = comma
+	if err := cw.Write(w.headerCells); err != nil {
+		return err
+	}
+	for _, r := range w.cellsFiltered {
+		if err := cw.Write(r.columns); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// exportJSONLocked writes cellsFiltered as a JSON array of objects keyed by
+// column title. Callers must hold w.mu.
+func (w *DataTable) exportJSONLocked(out io.Writer) error {
+	records := make([]map[string]string, len(w.cellsFiltered))
+	for i, r := range w.cellsFiltered {
+		rec := make(map[string]string, w.numCols)
+		for j, h := range w.headerCells {
+			rec[h] = r.columns[j]
+		}
+		records[i] = rec
+	}
+	return json.NewEncoder(out).Encode(records)
+}
+
+// showExportDialog opens a save-file dialog and exports the current view to
+// it, picking CSV, TSV or JSON from the chosen file name's extension.
+func (w *DataTable) showExportDialog() {
+	dialog.ShowFileSave(func(uc fyne.URIWriteCloser, err error) {
+		if err != nil || uc == nil {
+			return
+		}
+		defer uc.Close()
+		format := exportFormatForExtension(uc.URI().Extension())
+		if err := w.Export(uc, format); err != nil {
+			dialog.ShowError(err, w.window)
+		}
+	}, w.window)
+}
+
+// exportFormatForExtension maps a file extension (as returned by
+// [fyne.URI.Extension]) to the matching [ExportFormat], defaulting to
+// ExportCSV.
+func exportFormatForExtension(ext string) ExportFormat {
+	switch strings.ToLower(ext) {
+	case ".tsv":
+		return ExportTSV
+	case ".json":
+		return ExportJSON
+	default:
+		return ExportCSV
+	}
+}
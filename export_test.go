@@ -0,0 +1,103 @@
+package datatable
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func newExportTestTable(t *testing.T) *DataTable {
+	t.Helper()
+	w, err := New(Config{
+		Columns: []Column{{Title: "Name"}, {Title: "Score"}},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	data := [][]string{
+		{"Alice", "1"},
+		{"Bob", "2"},
+	}
+	if err := w.SetData(data); err != nil {
+		t.Fatalf("SetData() error = %v", err)
+	}
+	return w
+}
+
+func TestExportCSVIncludesHeaderAndRows(t *testing.T) {
+	w := newExportTestTable(t)
+
+	var buf bytes.Buffer
+	if err := w.Export(&buf, ExportCSV); err != nil {
+		t.Fatalf("Export(ExportCSV) error = %v", err)
+	}
+	want := "Name,Score\nAlice,1\nBob,2\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("Export(ExportCSV) = %q, want %q", got, want)
+	}
+}
+
+func TestExportTSVIncludesHeaderAndRows(t *testing.T) {
+	w := newExportTestTable(t)
+
+	var buf bytes.Buffer
+	if err := w.Export(&buf, ExportTSV); err != nil {
+		t.Fatalf("Export(ExportTSV) error = %v", err)
+	}
+	want := "Name\tScore\nAlice\t1\nBob\t2\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("Export(ExportTSV) = %q, want %q", got, want)
+	}
+}
+
+func TestExportJSONKeysRecordsByColumnTitle(t *testing.T) {
+	w := newExportTestTable(t)
+
+	var buf bytes.Buffer
+	if err := w.Export(&buf, ExportJSON); err != nil {
+		t.Fatalf("Export(ExportJSON) error = %v", err)
+	}
+	var records []map[string]string
+	if err := json.Unmarshal(buf.Bytes(), &records); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	want := []map[string]string{
+		{"Name": "Alice", "Score": "1"},
+		{"Name": "Bob", "Score": "2"},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("len(records) = %d, want %d", len(records), len(want))
+	}
+	for i, rec := range records {
+		for k, v := range want[i] {
+			if rec[k] != v {
+				t.Fatalf("records[%d][%q] = %q, want %q", i, k, rec[k], v)
+			}
+		}
+	}
+}
+
+func TestExportUnknownFormatReturnsError(t *testing.T) {
+	w := newExportTestTable(t)
+
+	var buf bytes.Buffer
+	if err := w.Export(&buf, ExportFormat(99)); err == nil {
+		t.Fatalf("Export() with an unknown format should return an error")
+	}
+}
+
+func TestExportFormatForExtension(t *testing.T) {
+	cases := map[string]ExportFormat{
+		".csv":  ExportCSV,
+		".tsv":  ExportTSV,
+		".json": ExportJSON,
+		".JSON": ExportJSON,
+		"":      ExportCSV,
+		".txt":  ExportCSV,
+	}
+	for ext, want := range cases {
+		if got := exportFormatForExtension(ext); got != want {
+			t.Errorf("exportFormatForExtension(%q) = %v, want %v", ext, got, want)
+		}
+	}
+}
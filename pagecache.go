@@ -0,0 +1,76 @@
+package datatable
+
+// dataProviderPageSize is the default number of rows fetched per call to a
+// [DataTable.SetDataProvider] fetch function and cached together as one
+// page, used when SetDataProvider is given a pageSize of 0 or less.
+const dataProviderPageSize = 200
+
+// pageCacheCapacity bounds the number of pages a pageCache keeps in memory
+// before evicting the least recently used one.
+const pageCacheCapacity = 20
+
+// pageCache is a small LRU cache of row pages fetched from a DataTable's
+// data provider, so scrolling through provider-backed data doesn't re-fetch
+// a page on every frame. It is not safe for concurrent use; callers must
+// serialize access through DataTable.mu.
+type pageCache struct {
+	pageSize int
+	pages    map[int][][]string
+	lru      []int // page indices, least recently used first
+}
+
+// newPageCache returns an empty pageCache holding pages of pageSize rows.
+func newPageCache(pageSize int) *pageCache {
+	return &pageCache{
+		pageSize: pageSize,
+		pages:    make(map[int][][]string),
+	}
+}
+
+// clear drops every cached page, e.g. after the data provider's sort or
+// filter changes and its row order can no longer be trusted.
+func (c *pageCache) clear() {
+	c.pages = make(map[int][][]string)
+	c.lru = nil
+}
+
+// row returns the row at position id, fetching and caching its page via
+// fetch on a cache miss. Returns nil if fetch returned fewer rows than id
+// falls within.
+func (c *pageCache) row(id int, fetch func(offset, limit int) [][]string) []string {
+	page := id / c.pageSize
+	rows, ok := c.pages[page]
+	if !ok {
+		rows = fetch(page*c.pageSize, c.pageSize)
+		c.put(page, rows)
+	} else {
+		c.touch(page)
+	}
+	i := id % c.pageSize
+	if i >= len(rows) {
+		return nil
+	}
+	return rows[i]
+}
+
+// put stores rows for page and evicts the least recently used page once the
+// cache grows past pageCacheCapacity.
+func (c *pageCache) put(page int, rows [][]string) {
+	c.pages[page] = rows
+	c.touch(page)
+	if len(c.lru) > pageCacheCapacity {
+		delete(c.pages, c.lru[0])
+		c.lru = c.lru[1:]
+	}
+}
+
+// touch marks page as the most recently used.
+func (c *pageCache) touch(page int) {
+	for i, p := range c.lru {
+		if p == page {
+			c.lru = append(c.lru[:i], c.lru[i+1:]...)
+			break
+		}
+	}
+	c.lru = append(c.lru, page)
+}
@@ -0,0 +1,103 @@
+package datatable
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestPageCacheRowCachesPage(t *testing.T) {
+	fetches := 0
+	fetch := func(offset, limit int) [][]string {
+		fetches++
+		rows := make([][]string, limit)
+		for i := range rows {
+			rows[i] = []string{strconv.Itoa(offset + i)}
+		}
+		return rows
+	}
+	c := newPageCache(2)
+
+	if got := c.row(0, fetch); got[0] != "0" {
+		t.Fatalf("row(0) = %v, want [0]", got)
+	}
+	if got := c.row(1, fetch); got[0] != "1" {
+		t.Fatalf("row(1) = %v, want [1]", got)
+	}
+	if fetches != 1 {
+		t.Fatalf("fetches = %d, want 1 (rows 0 and 1 share a page)", fetches)
+	}
+
+	if got := c.row(2, fetch); got[0] != "2" {
+		t.Fatalf("row(2) = %v, want [2]", got)
+	}
+	if fetches != 2 {
+		t.Fatalf("fetches = %d, want 2 (row 2 is on a new page)", fetches)
+	}
+}
+
+func TestPageCacheRowShortPageReturnsNil(t *testing.T) {
+	fetch := func(offset, limit int) [][]string {
+		return [][]string{{"only-row"}} // fewer rows than the page size
+	}
+	c := newPageCache(10)
+
+	if got := c.row(0, fetch); got == nil || got[0] != "only-row" {
+		t.Fatalf("row(0) = %v, want [only-row]", got)
+	}
+	if got := c.row(1, fetch); got != nil {
+		t.Fatalf("row(1) = %v, want nil (past the end of a short page)", got)
+	}
+}
+
+func TestPageCacheClearForcesRefetch(t *testing.T) {
+	fetches := 0
+	fetch := func(offset, limit int) [][]string {
+		fetches++
+		return [][]string{{"row"}}
+	}
+	c := newPageCache(10)
+	c.row(0, fetch)
+	c.clear()
+	c.row(0, fetch)
+
+	if fetches != 2 {
+		t.Fatalf("fetches = %d, want 2 (clear must force a refetch)", fetches)
+	}
+	if len(c.pages) != 1 || len(c.lru) != 1 {
+		t.Fatalf("cache state after clear+refetch = %d pages, %d lru entries, want 1 and 1", len(c.pages), len(c.lru))
+	}
+}
+
+func TestPageCacheEvictsLeastRecentlyUsedPage(t *testing.T) {
+	fetches := 0
+	fetch := func(offset, limit int) [][]string {
+		fetches++
+		return [][]string{{"row"}}
+	}
+	c := newPageCache(1) // 1 row per page, so page index == id
+
+	for page := 0; page <= pageCacheCapacity; page++ {
+		c.row(page, fetch)
+	}
+	if _, ok := c.pages[0]; ok {
+		t.Fatalf("page 0 should have been evicted once the cache grew past capacity")
+	}
+	if _, ok := c.pages[pageCacheCapacity]; !ok {
+		t.Fatalf("most recently fetched page should still be cached")
+	}
+
+	// Touching page 1 should protect it from the next eviction ahead of the
+	// untouched page 2.
+	c.row(1, fetch)
+	beforeFetches := fetches
+	c.row(pageCacheCapacity+1, fetch) // triggers another eviction
+	if fetches != beforeFetches+1 {
+		t.Fatalf("expected exactly one new fetch for the new page")
+	}
+	if _, ok := c.pages[1]; !ok {
+		t.Fatalf("recently touched page 1 should not have been evicted")
+	}
+	if _, ok := c.pages[2]; ok {
+		t.Fatalf("untouched page 2 should have been evicted instead of page 1")
+	}
+}
@@ -0,0 +1,82 @@
+package datatable
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// dividerHandleWidth is the width of the invisible, draggable strip overlaid
+// on a column boundary.
+const dividerHandleWidth float32 = 6
+
+// dividerLayout positions one handle at the boundary to the right of each
+// non-last column, tracking the same widths as columnsLayout.
+type dividerLayout struct {
+	widths columnsLayout
+}
+
+func (l dividerLayout) MinSize(_ []fyne.CanvasObject) fyne.Size {
+	return fyne.NewSize(0, 0)
+}
+
+func (l dividerLayout) Layout(objects []fyne.CanvasObject, containerSize fyne.Size) {
+	padding := theme.Padding()
+	var x float32
+	for i, o := range objects {
+		x += l.widths[i]
+		o.Resize(fyne.NewSize(dividerHandleWidth, containerSize.Height))
+		o.Move(fyne.NewPos(x+padding/2-dividerHandleWidth/2, 0))
+		x += padding
+	}
+}
+
+// columnDivider is an invisible handle overlaid on the boundary to the
+// right of a header column, letting users resize that column by dragging it
+// and autosize it with a double-click.
+type columnDivider struct {
+	widget.BaseWidget
+
+	col   int
+	table *DataTable
+}
+
+var _ fyne.Draggable = (*columnDivider)(nil)
+var _ fyne.DoubleTappable = (*columnDivider)(nil)
+var _ desktop.Cursorable = (*columnDivider)(nil)
+
+// newColumnDivider returns a resize handle for the boundary to the right of
+// column col on table.
+func newColumnDivider(table *DataTable, col int) *columnDivider {
+	d := &columnDivider{col: col, table: table}
+	d.ExtendBaseWidget(d)
+	return d
+}
+
+func (d *columnDivider) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(canvas.NewRectangle(color.Transparent))
+}
+
+// Dragged grows or shrinks the column to this divider's left by the drag's
+// horizontal delta.
+func (d *columnDivider) Dragged(e *fyne.DragEvent) {
+	d.table.resizeColumn(d.col, e.Dragged.DX)
+}
+
+// DragEnd is needed to satisfy fyne.Draggable; resizing already happens live
+// in Dragged, so there is nothing left to do once the drag finishes.
+func (d *columnDivider) DragEnd() {}
+
+// DoubleTapped autosizes the column to this divider's left.
+func (d *columnDivider) DoubleTapped(_ *fyne.PointEvent) {
+	d.table.autosizeColumn(d.col)
+}
+
+// Cursor shows a horizontal resize cursor while hovering the divider.
+func (d *columnDivider) Cursor() desktop.Cursor {
+	return desktop.HResizeCursor
+}
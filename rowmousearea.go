@@ -0,0 +1,48 @@
+package datatable
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+)
+
+// rowMouseArea wraps a body row's cell content so its Shift/Ctrl/Cmd click
+// modifiers can be read straight off the mouse event, the same way
+// [tappableLabel] does for header clicks.
+//
+// The table previously tracked these modifiers via KeyDown/KeyUp, but Fyne's
+// driver only delivers key events to the currently focused widget, and the
+// table only gains focus once a row has already been clicked. That left the
+// very first Ctrl/Cmd+Click or Shift+Click silently downgraded to a plain
+// click whenever focus started elsewhere (e.g. on the search entry).
+// MouseDown, by contrast, is dispatched by position regardless of focus.
+type rowMouseArea struct {
+	widget.BaseWidget
+	content     fyne.CanvasObject
+	onMouseDown func(modifier fyne.KeyModifier)
+}
+
+var _ desktop.Mouseable = (*rowMouseArea)(nil)
+
+// newRowMouseArea returns a rowMouseArea displaying content, calling
+// onMouseDown with the mouse button's modifier keys whenever content is
+// pressed.
+func newRowMouseArea(content fyne.CanvasObject, onMouseDown func(modifier fyne.KeyModifier)) *rowMouseArea {
+	a := &rowMouseArea{content: content, onMouseDown: onMouseDown}
+	a.ExtendBaseWidget(a)
+	return a
+}
+
+func (a *rowMouseArea) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(a.content)
+}
+
+// MouseDown is a hook that is called if a mouse button is pressed over the element.
+func (a *rowMouseArea) MouseDown(e *desktop.MouseEvent) {
+	if a.onMouseDown != nil {
+		a.onMouseDown(e.Modifier)
+	}
+}
+
+// MouseUp is a hook that is called if a mouse button is released over the element.
+func (a *rowMouseArea) MouseUp(*desktop.MouseEvent) {}
@@ -0,0 +1,126 @@
+package datatable
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+)
+
+var _ fyne.Focusable = (*DataTable)(nil)
+var _ desktop.Keyable = (*DataTable)(nil)
+var _ fyne.Shortcutable = (*DataTable)(nil)
+
+// FocusGained is called when the table gains keyboard focus.
+func (w *DataTable) FocusGained() {}
+
+// FocusLost is called when the table loses keyboard focus.
+func (w *DataTable) FocusLost() {}
+
+// TypedRune is needed to satisfy fyne.Focusable; the table has no text input.
+func (w *DataTable) TypedRune(_ rune) {}
+
+// TypedKey moves the keyboard-focused row with the arrow keys. In
+// SelectionMulti mode, holding Shift extends the current selection to the
+// new row instead of replacing it.
+func (w *DataTable) TypedKey(e *fyne.KeyEvent) {
+	if w.selectionMode == SelectionNone {
+		return
+	}
+	switch e.Name {
+	case fyne.KeyUp:
+		w.moveFocus(-1)
+	case fyne.KeyDown:
+		w.moveFocus(1)
+	}
+}
+
+// KeyDown tracks the Shift and Ctrl/Cmd modifier keys while they are held,
+// so Shift+Arrow navigation can extend the selection range. Row clicks read
+// the modifiers from the mouse event instead (see rowMouseArea).
+func (w *DataTable) KeyDown(e *fyne.KeyEvent) {
+	switch e.Name {
+	case desktop.KeyShiftLeft, desktop.KeyShiftRight:
+		w.shiftHeld = true
+	case desktop.KeyControlLeft, desktop.KeyControlRight, desktop.KeySuperLeft, desktop.KeySuperRight:
+		w.ctrlHeld = true
+	}
+}
+
+// KeyUp clears the Shift and Ctrl/Cmd modifier tracking set by KeyDown.
+func (w *DataTable) KeyUp(e *fyne.KeyEvent) {
+	switch e.Name {
+	case desktop.KeyShiftLeft, desktop.KeyShiftRight:
+		w.shiftHeld = false
+	case desktop.KeyControlLeft, desktop.KeyControlRight, desktop.KeySuperLeft, desktop.KeySuperRight:
+		w.ctrlHeld = false
+	}
+}
+
+// TypedShortcut copies the selected rows to the clipboard as TSV on Ctrl/Cmd+C.
+func (w *DataTable) TypedShortcut(shortcut fyne.Shortcut) {
+	cpy, ok := shortcut.(*fyne.ShortcutCopy)
+	if !ok {
+		return
+	}
+	cpy.Clipboard.SetContent(w.selectedRowsAsTSV())
+}
+
+// moveFocus moves the keyboard-focused row by delta positions among the
+// currently visible rows, selecting it (or extending the selection range
+// when Shift is held in SelectionMulti mode) and scrolling it into view.
+func (w *DataTable) moveFocus(delta int) {
+	w.mu.Lock()
+	n := w.visibleLenLocked()
+	if n == 0 {
+		w.mu.Unlock()
+		return
+	}
+	next := w.focusedRow + delta
+	next = max(0, min(next, n-1))
+	if w.selectionMode == SelectionMulti && w.shiftHeld {
+		if w.anchorRow == -1 {
+			w.anchorRow = next
+		}
+		w.selectRangeLocked(w.anchorRow, next)
+	} else if r, ok := w.rowAt(next); ok {
+		w.selected = map[int]bool{r.idx: true}
+		w.anchorRow = next
+	}
+	w.focusedRow = next
+	w.mu.Unlock()
+	w.notifySelectionChanged()
+	w.body.Refresh()
+	w.body.ScrollTo(next)
+}
+
+// selectedRowsAsTSV renders the currently selected rows as tab-separated
+// values, one row per line, in their current sort/filter order.
+//
+// For a data-provider-backed table, a selected row's index is its position
+// with the provider, so only the selected rows themselves are fetched
+// (via rowAt/the page cache) rather than walking every row to find them.
+func (w *DataTable) selectedRowsAsTSV() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var b strings.Builder
+	if w.dataProvider != nil {
+		for _, idx := range w.selectedRowsLocked() {
+			r, ok := w.rowAt(idx)
+			if !ok {
+				continue
+			}
+			b.WriteString(strings.Join(r.columns, "\t"))
+			b.WriteString("\n")
+		}
+		return b.String()
+	}
+	for _, r := range w.cellsFiltered {
+		if !w.selected[r.idx] {
+			continue
+		}
+		b.WriteString(strings.Join(r.columns, "\t"))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
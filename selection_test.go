@@ -0,0 +1,71 @@
+package datatable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newSelectionTestTable(t *testing.T, mode SelectionMode) *DataTable {
+	t.Helper()
+	w, err := New(Config{
+		Columns:       []Column{{Title: "Value"}},
+		SelectionMode: mode,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	data := [][]string{{"0"}, {"1"}, {"2"}, {"3"}, {"4"}}
+	if err := w.SetData(data); err != nil {
+		t.Fatalf("SetData() error = %v", err)
+	}
+	return w
+}
+
+func TestSelectRangeLockedSelectsInclusiveRange(t *testing.T) {
+	w := newSelectionTestTable(t, SelectionMulti)
+
+	w.mu.Lock()
+	w.selectRangeLocked(1, 3)
+	w.mu.Unlock()
+
+	if got, want := w.SelectedRows(), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("SelectedRows() = %v, want %v", got, want)
+	}
+}
+
+func TestSelectRangeLockedNormalizesReversedBounds(t *testing.T) {
+	w := newSelectionTestTable(t, SelectionMulti)
+
+	w.mu.Lock()
+	w.selectRangeLocked(3, 1)
+	w.mu.Unlock()
+
+	if got, want := w.SelectedRows(), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("SelectedRows() = %v, want %v", got, want)
+	}
+}
+
+func TestSelectRangeLockedClampsToVisibleRows(t *testing.T) {
+	w := newSelectionTestTable(t, SelectionMulti)
+
+	w.mu.Lock()
+	w.selectRangeLocked(3, 100)
+	w.mu.Unlock()
+
+	if got, want := w.SelectedRows(), []int{3, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("SelectedRows() = %v, want %v", got, want)
+	}
+}
+
+func TestSelectRangeLockedReplacesPriorSelection(t *testing.T) {
+	w := newSelectionTestTable(t, SelectionMulti)
+
+	w.SelectRow(0)
+	w.mu.Lock()
+	w.selectRangeLocked(2, 3)
+	w.mu.Unlock()
+
+	if got, want := w.SelectedRows(), []int{2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("SelectedRows() = %v, want %v (prior selection should be replaced)", got, want)
+	}
+}
@@ -0,0 +1,107 @@
+package datatable
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+)
+
+func TestMain(m *testing.M) {
+	test.NewApp()
+	os.Exit(m.Run())
+}
+
+func newSortTestTable(t *testing.T) *DataTable {
+	t.Helper()
+	w, err := New(Config{
+		Columns: []Column{{Title: "Category"}, {Title: "Value"}},
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	data := [][]string{
+		{"B", "2"},
+		{"A", "3"},
+		{"B", "1"},
+		{"A", "1"},
+	}
+	if err := w.SetData(data); err != nil {
+		t.Fatalf("SetData() error = %v", err)
+	}
+	return w
+}
+
+func visibleRows(w *DataTable) [][]string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	rows := make([][]string, len(w.cellsFiltered))
+	for i, r := range w.cellsFiltered {
+		rows[i] = r.columns
+	}
+	return rows
+}
+
+func TestMultiColumnSortOrdersByPriority(t *testing.T) {
+	w := newSortTestTable(t)
+
+	if err := w.SetSort([]int{0, 1}, []SortDir{SortAsc, SortAsc}); err != nil {
+		t.Fatalf("SetSort() error = %v", err)
+	}
+	want := [][]string{
+		{"A", "1"},
+		{"A", "3"},
+		{"B", "1"},
+		{"B", "2"},
+	}
+	if got := visibleRows(w); !reflect.DeepEqual(got, want) {
+		t.Fatalf("rows sorted by (Category asc, Value asc) = %v, want %v", got, want)
+	}
+}
+
+func TestMultiColumnSortSecondaryDirectionIsIndependent(t *testing.T) {
+	w := newSortTestTable(t)
+
+	if err := w.SetSort([]int{0, 1}, []SortDir{SortAsc, SortDesc}); err != nil {
+		t.Fatalf("SetSort() error = %v", err)
+	}
+	want := [][]string{
+		{"A", "3"},
+		{"A", "1"},
+		{"B", "2"},
+		{"B", "1"},
+	}
+	if got := visibleRows(w); !reflect.DeepEqual(got, want) {
+		t.Fatalf("rows sorted by (Category asc, Value desc) = %v, want %v", got, want)
+	}
+}
+
+func TestSortIsStableWhenNoSortColumnsDiffer(t *testing.T) {
+	w := newSortTestTable(t)
+
+	// Sorting by a column where every row ties must preserve the original
+	// relative order of the input data (stable sort).
+	data := [][]string{
+		{"same", "2"},
+		{"same", "1"},
+		{"same", "3"},
+	}
+	if err := w.SetData(data); err != nil {
+		t.Fatalf("SetData() error = %v", err)
+	}
+	if err := w.SetSort([]int{0}, []SortDir{SortAsc}); err != nil {
+		t.Fatalf("SetSort() error = %v", err)
+	}
+	if got := visibleRows(w); !reflect.DeepEqual(got, data) {
+		t.Fatalf("rows = %v, want unchanged input order %v", got, data)
+	}
+}
+
+func TestSetSortRejectsInvalidColumnIndex(t *testing.T) {
+	w := newSortTestTable(t)
+
+	if err := w.SetSort([]int{5}, []SortDir{SortAsc}); err == nil {
+		t.Fatalf("SetSort() with out-of-range column index should return an error")
+	}
+}
@@ -11,16 +11,20 @@ type tappableLabel struct {
 	widget.Label
 
 	// The function that is called when the label is tapped.
-	OnTapped func()
+	// shift reports whether the Shift key was held down during the click.
+	OnTapped func(shift bool)
 
-	hovered bool
+	hovered   bool
+	shiftHeld bool
+	disabled  bool
 }
 
 var _ fyne.Tappable = (*tappableLabel)(nil)
 var _ desktop.Hoverable = (*tappableLabel)(nil)
+var _ desktop.Mouseable = (*tappableLabel)(nil)
 
 // newTappableLabel returns a new TappableLabel instance.
-func newTappableLabel(text string, tapped func()) *tappableLabel {
+func newTappableLabel(text string, tapped func(shift bool)) *tappableLabel {
 	l := &tappableLabel{OnTapped: tapped}
 	l.ExtendBaseWidget(l)
 	l.SetText(text)
@@ -28,14 +32,29 @@ func newTappableLabel(text string, tapped func()) *tappableLabel {
 }
 
 func (l *tappableLabel) Tapped(_ *fyne.PointEvent) {
-	if l.OnTapped != nil {
-		l.OnTapped()
+	if !l.disabled && l.OnTapped != nil {
+		l.OnTapped(l.shiftHeld)
 	}
 }
 
+// MouseDown is a hook that is called if a mouse button is pressed over the element.
+func (l *tappableLabel) MouseDown(e *desktop.MouseEvent) {
+	l.shiftHeld = e.Modifier&fyne.KeyModifierShift != 0
+}
+
+// SetDisabled makes the label stop responding to taps when disabled is true,
+// e.g. while it represents a header whose column cannot currently be sorted.
+func (l *tappableLabel) SetDisabled(disabled bool) {
+	l.disabled = disabled
+}
+
+// MouseUp is a hook that is called if a mouse button is released over the element.
+func (l *tappableLabel) MouseUp(_ *desktop.MouseEvent) {
+}
+
 // Cursor returns the cursor type of this widget
 func (l *tappableLabel) Cursor() desktop.Cursor {
-	if l.hovered {
+	if l.hovered && !l.disabled {
 		return desktop.PointerCursor
 	}
 	return desktop.DefaultCursor